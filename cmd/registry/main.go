@@ -42,6 +42,9 @@ func main() {
 
 	// Initialize configuration
 	cfg := config.NewConfig()
+	cfg.BuildVersion = Version
+	cfg.GitCommit = GitCommit
+	cfg.BuildTime = BuildTime
 
 	// Initialize services based on environment
 	switch cfg.DatabaseType {
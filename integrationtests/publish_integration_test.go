@@ -11,6 +11,7 @@ import (
 
 	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
 	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/model"
 	"github.com/modelcontextprotocol/registry/internal/service"
 	"github.com/stretchr/testify/assert"
@@ -56,7 +57,7 @@ func TestPublishIntegration(t *testing.T) {
 	authService := &MockAuthService{}
 
 	// Create the publish handler
-	handler := v0.PublishHandler(registryService, authService)
+	handler := v0.PublishHandler(&config.Config{}, registryService, authService)
 
 	t.Run("successful publish with GitHub auth", func(t *testing.T) {
 		publishReq := model.PublishRequest{
@@ -510,7 +511,7 @@ func TestPublishIntegration(t *testing.T) {
 func TestPublishIntegrationWithComplexPackages(t *testing.T) {
 	registryService := service.NewFakeRegistryService()
 	authService := &MockAuthService{}
-	handler := v0.PublishHandler(registryService, authService)
+	handler := v0.PublishHandler(&config.Config{}, registryService, authService)
 
 	t.Run("publish with complex package configuration", func(t *testing.T) {
 		serverDetail := &model.ServerDetail{
@@ -661,11 +662,11 @@ func TestPublishIntegrationWithComplexPackages(t *testing.T) {
 func TestPublishIntegrationEndToEnd(t *testing.T) {
 	registryService := service.NewFakeRegistryService()
 	authService := &MockAuthService{}
-	handler := v0.PublishHandler(registryService, authService)
+	handler := v0.PublishHandler(&config.Config{}, registryService, authService)
 
 	t.Run("end-to-end publish and retrieve flow", func(t *testing.T) {
 		// Step 1: Get initial count of servers
-		initialServers, _, err := registryService.List("", 100)
+		initialServers, _, err := registryService.List(nil, "", 100)
 		require.NoError(t, err)
 		initialCount := len(initialServers)
 
@@ -703,7 +704,7 @@ func TestPublishIntegrationEndToEnd(t *testing.T) {
 		require.Equal(t, http.StatusCreated, recorder.Code)
 
 		// Step 3: Verify the count increased
-		updatedServers, _, err := registryService.List("", 100)
+		updatedServers, _, err := registryService.List(nil, "", 100)
 		require.NoError(t, err)
 		assert.Equal(t, initialCount+1, len(updatedServers))
 
@@ -0,0 +1,88 @@
+package integrationtests_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
+	"github.com/modelcontextprotocol/registry/internal/database"
+	"github.com/modelcontextprotocol/registry/internal/model"
+	"github.com/modelcontextprotocol/registry/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestServersUpdatedSinceIntegration tests incremental listing against the in-memory database
+func TestServersUpdatedSinceIntegration(t *testing.T) {
+	servers := map[string]*model.Server{}
+	for id, releaseDate := range map[string]string{
+		"550e8400-e29b-41d4-a716-446655440001": "2025-05-20T00:00:00Z",
+		"550e8400-e29b-41d4-a716-446655440002": "2025-05-25T12:00:00Z",
+		"550e8400-e29b-41d4-a716-446655440003": "2025-05-30T00:00:00Z",
+	} {
+		servers[id] = &model.Server{
+			ID:   id,
+			Name: "server-" + id,
+			VersionDetail: model.VersionDetail{
+				Version:     "1.0.0",
+				ReleaseDate: releaseDate,
+				IsLatest:    true,
+			},
+		}
+	}
+
+	registryService := service.NewRegistryServiceWithDB(database.NewMemoryDB(servers))
+	handler := v0.ServersHandler(registryService)
+
+	testCases := []struct {
+		name         string
+		updatedSince string
+		expectedIDs  []string
+	}{
+		{
+			name:         "window includes servers released at or after the timestamp",
+			updatedSince: "2025-05-25T12:00:00Z",
+			expectedIDs: []string{
+				"550e8400-e29b-41d4-a716-446655440002",
+				"550e8400-e29b-41d4-a716-446655440003",
+			},
+		},
+		{
+			name:         "timestamps with offsets are compared in absolute time",
+			updatedSince: "2025-05-25T10:00:00-05:00",
+			expectedIDs: []string{
+				"550e8400-e29b-41d4-a716-446655440003",
+			},
+		},
+		{
+			name:         "window after the newest release is empty",
+			updatedSince: "2025-06-01T00:00:00Z",
+			expectedIDs:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(
+				context.Background(), http.MethodGet, "/v0/servers?updated_since="+tc.updatedSince, nil,
+			)
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			var resp v0.PaginatedResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+
+			ids := make([]string, 0, len(resp.Data))
+			for _, server := range resp.Data {
+				ids = append(ids, server.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
@@ -3,7 +3,7 @@ package v0
 
 import (
 	"encoding/json"
-	"io"
+	"errors"
 	"net/http"
 
 	"github.com/modelcontextprotocol/registry/internal/auth"
@@ -19,13 +19,17 @@ func StartAuthHandler(authService auth.Service) http.HandlerFunc {
 			return
 		}
 
-		// Read the request body
-		body, err := io.ReadAll(r.Body)
+		// Read the request body, bounded by the default size limit
+		body, err := readRequestBody(w, r, defaultMaxRequestBodySize)
 		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
 			http.Error(w, "Error reading request body", http.StatusBadRequest)
 			return
 		}
-		defer r.Body.Close()
 
 		// Parse request body into AuthRequest struct
 		var authReq struct {
@@ -0,0 +1,23 @@
+// Package v0 contains API handlers for version 0 of the API
+package v0
+
+import (
+	"io"
+	"net/http"
+)
+
+// defaultMaxRequestBodySize is used when no positive body size limit is configured
+const defaultMaxRequestBodySize int64 = 1 << 20
+
+// readRequestBody reads the whole request body, failing with an *http.MaxBytesError
+// once more than maxBytes have been read
+func readRequestBody(w http.ResponseWriter, r *http.Request, maxBytes int64) ([]byte, error) {
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxRequestBodySize
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+	defer r.Body.Close()
+
+	return io.ReadAll(r.Body)
+}
@@ -0,0 +1,71 @@
+// Package v0 contains API handlers for version 0 of the API
+package v0
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// parseFields parses a comma-separated fields query parameter, checking each
+// field against the top-level JSON keys of sample
+func parseFields(raw string, sample any) ([]string, error) {
+	allowed, err := toJSONObject(sample)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if _, ok := allowed[field]; !ok {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields requested")
+	}
+
+	return fields, nil
+}
+
+// selectFields marshals each item and keeps only the requested top-level fields
+func selectFields[T any](items []T, fields []string) ([]map[string]json.RawMessage, error) {
+	result := make([]map[string]json.RawMessage, len(items))
+	for i, item := range items {
+		object, err := toJSONObject(item)
+		if err != nil {
+			return nil, err
+		}
+
+		selected := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if value, ok := object[field]; ok {
+				selected[field] = value
+			}
+		}
+		result[i] = selected
+	}
+
+	return result, nil
+}
+
+// toJSONObject marshals v and decodes it back into its top-level JSON fields
+func toJSONObject(v any) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
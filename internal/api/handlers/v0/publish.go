@@ -4,19 +4,65 @@ package v0
 import (
 	"encoding/json"
 	"errors"
-	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/database"
 	"github.com/modelcontextprotocol/registry/internal/model"
 	"github.com/modelcontextprotocol/registry/internal/service"
 	"golang.org/x/net/html"
 )
 
+// FieldError describes a validation failure for a single request field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse is returned when a request fails field validation
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`
+	Errors []FieldError `json:"errors"`
+}
+
+// validatePublishRequest checks the required fields of a publish request and
+// returns every failure rather than stopping at the first one
+func validatePublishRequest(serverDetail *model.ServerDetail) []FieldError {
+	var fieldErrors []FieldError
+
+	if serverDetail.Name == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "name", Message: "Name is required"})
+	}
+
+	if serverDetail.VersionDetail.Version == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "version_detail.version", Message: "Version is required"})
+	}
+
+	return fieldErrors
+}
+
+// writeValidationErrors writes the field errors as a 400 JSON response
+func writeValidationErrors(w http.ResponseWriter, fieldErrors []FieldError) {
+	messages := make([]string, len(fieldErrors))
+	for i, fieldErr := range fieldErrors {
+		messages[i] = fieldErr.Message
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	if err := json.NewEncoder(w).Encode(ValidationErrorResponse{
+		Error:  strings.Join(messages, "; "),
+		Errors: fieldErrors,
+	}); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
 // PublishHandler handles requests to publish new server details to the registry
-func PublishHandler(registry service.RegistryService, authService auth.Service) http.HandlerFunc {
+func PublishHandler(cfg *config.Config, registry service.RegistryService, authService auth.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Only allow POST method
 		if r.Method != http.MethodPost {
@@ -24,13 +70,24 @@ func PublishHandler(registry service.RegistryService, authService auth.Service)
 			return
 		}
 
-		// Read the request body
-		body, err := io.ReadAll(r.Body)
+		// Reject writes while the registry is in maintenance mode
+		if cfg.MaintenanceMode {
+			w.Header().Set("Retry-After", strconv.Itoa(cfg.MaintenanceRetryAfter))
+			http.Error(w, "Registry is in maintenance mode, publishing is temporarily disabled", http.StatusServiceUnavailable)
+			return
+		}
+
+		// Read the request body, bounded by the configured size limit
+		body, err := readRequestBody(w, r, cfg.MaxRequestBodySize)
 		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
 			http.Error(w, "Error reading request body", http.StatusBadRequest)
 			return
 		}
-		defer r.Body.Close()
 
 		// Parse request body into PublishRequest struct
 		var publishReq model.PublishRequest
@@ -48,15 +105,9 @@ func PublishHandler(registry service.RegistryService, authService auth.Service)
 			http.Error(w, "Invalid server detail payload: "+err.Error(), http.StatusBadRequest)
 			return
 		}
-		// Validate required fields
-		if serverDetail.Name == "" {
-			http.Error(w, "Name is required", http.StatusBadRequest)
-			return
-		}
-
-		// Version is required
-		if serverDetail.VersionDetail.Version == "" {
-			http.Error(w, "Version is required", http.StatusBadRequest)
+		// Validate required fields, reporting all failures together
+		if fieldErrors := validatePublishRequest(&serverDetail); len(fieldErrors) > 0 {
+			writeValidationErrors(w, fieldErrors)
 			return
 		}
 
@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
 	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,8 +22,8 @@ type MockRegistryService struct {
 	mock.Mock
 }
 
-func (m *MockRegistryService) List(cursor string, limit int) ([]model.Server, string, error) {
-	args := m.Mock.Called(cursor, limit)
+func (m *MockRegistryService) List(filter map[string]interface{}, cursor string, limit int) ([]model.Server, string, error) {
+	args := m.Mock.Called(filter, cursor, limit)
 	return args.Get(0).([]model.Server), args.String(1), args.Error(2)
 }
 
@@ -369,7 +371,7 @@ func TestPublishHandler(t *testing.T) {
 			tc.setupMocks(mockRegistry, mockAuthService)
 
 			// Create handler
-			handler := v0.PublishHandler(mockRegistry, mockAuthService)
+			handler := v0.PublishHandler(&config.Config{}, mockRegistry, mockAuthService)
 
 			// Prepare request body
 			var requestBody []byte
@@ -459,7 +461,7 @@ func TestPublishHandlerBearerTokenParsing(t *testing.T) {
 			})).Return(true, nil)
 			mockRegistry.Mock.On("Publish", mock.AnythingOfType("*model.ServerDetail")).Return(nil)
 
-			handler := v0.PublishHandler(mockRegistry, mockAuthService)
+			handler := v0.PublishHandler(&config.Config{}, mockRegistry, mockAuthService)
 
 			serverDetail := model.ServerDetail{
 				Server: model.Server{
@@ -524,7 +526,7 @@ func TestPublishHandlerAuthMethodSelection(t *testing.T) {
 			})).Return(true, nil)
 			mockRegistry.Mock.On("Publish", mock.AnythingOfType("*model.ServerDetail")).Return(nil)
 
-			handler := v0.PublishHandler(mockRegistry, mockAuthService)
+			handler := v0.PublishHandler(&config.Config{}, mockRegistry, mockAuthService)
 
 			serverDetail := model.ServerDetail{
 				Server: model.Server{
@@ -554,3 +556,141 @@ func TestPublishHandlerAuthMethodSelection(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishHandlerMaintenanceMode(t *testing.T) {
+	mockRegistry := new(MockRegistryService)
+	mockAuthService := new(MockAuthService)
+
+	cfg := &config.Config{
+		MaintenanceMode:       true,
+		MaintenanceRetryAfter: 120,
+	}
+	handler := v0.PublishHandler(cfg, mockRegistry, mockAuthService)
+
+	serverDetail := model.ServerDetail{
+		Server: model.Server{
+			Name:        "io.github.example/test-server",
+			Description: "A test server",
+			VersionDetail: model.VersionDetail{
+				Version: "1.0.0",
+			},
+		},
+	}
+
+	requestBody, err := json.Marshal(serverDetail)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/publish", bytes.NewBuffer(requestBody))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer test_token")
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "120", rr.Header().Get("Retry-After"))
+	assert.Contains(t, rr.Body.String(), "maintenance mode")
+
+	// Neither authentication nor the registry should be touched while writes are frozen
+	mockAuthService.Mock.AssertNotCalled(t, "ValidateAuth", mock.Anything, mock.Anything)
+	mockRegistry.Mock.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func TestPublishHandlerRequestBodyLimit(t *testing.T) {
+	serverDetail := model.ServerDetail{
+		Server: model.Server{
+			Name:        "example/test-server",
+			Description: strings.Repeat("a", 512),
+			VersionDetail: model.VersionDetail{
+				Version: "1.0.0",
+			},
+		},
+	}
+	requestBody, err := json.Marshal(serverDetail)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		maxBodySize    int64
+		expectedStatus int
+	}{
+		{
+			name:           "body over the limit is rejected",
+			maxBodySize:    256,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "body within the limit is accepted",
+			maxBodySize:    int64(len(requestBody)),
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "unset limit falls back to the default",
+			maxBodySize:    0,
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRegistry := new(MockRegistryService)
+			mockAuthService := new(MockAuthService)
+			mockAuthService.Mock.On("ValidateAuth", mock.Anything, mock.Anything).Return(true, nil)
+			mockRegistry.Mock.On("Publish", mock.AnythingOfType("*model.ServerDetail")).Return(nil)
+
+			handler := v0.PublishHandler(&config.Config{MaxRequestBodySize: tc.maxBodySize}, mockRegistry, mockAuthService)
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/publish", bytes.NewBuffer(requestBody))
+			assert.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test_token")
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedStatus == http.StatusRequestEntityTooLarge {
+				assert.Contains(t, rr.Body.String(), "Request body too large")
+				mockRegistry.Mock.AssertNotCalled(t, "Publish", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestPublishHandlerValidationErrors(t *testing.T) {
+	mockRegistry := new(MockRegistryService)
+	mockAuthService := new(MockAuthService)
+
+	handler := v0.PublishHandler(&config.Config{}, mockRegistry, mockAuthService)
+
+	serverDetail := model.ServerDetail{
+		Server: model.Server{
+			Description: "A server missing its name and version",
+		},
+	}
+
+	requestBody, err := json.Marshal(serverDetail)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/publish", bytes.NewBuffer(requestBody))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer test_token")
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var response v0.ValidationErrorResponse
+	err = json.NewDecoder(rr.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Name is required; Version is required", response.Error)
+	assert.Equal(t, []v0.FieldError{
+		{Field: "name", Message: "Name is required"},
+		{Field: "version_detail.version", Message: "Version is required"},
+	}, response.Errors)
+
+	mockAuthService.Mock.AssertNotCalled(t, "ValidateAuth", mock.Anything, mock.Anything)
+	mockRegistry.Mock.AssertNotCalled(t, "Publish", mock.Anything)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/registry/internal/model"
@@ -17,6 +18,12 @@ type PaginatedResponse struct {
 	Metadata Metadata       `json:"metadata,omitempty"`
 }
 
+// SparsePaginatedResponse is a paginated API response restricted to the requested fields
+type SparsePaginatedResponse struct {
+	Data     []map[string]json.RawMessage `json:"servers"`
+	Metadata Metadata                     `json:"metadata,omitempty"`
+}
+
 // Metadata contains pagination metadata
 type Metadata struct {
 	NextCursor string `json:"next_cursor,omitempty"`
@@ -68,26 +75,61 @@ func ServersHandler(registry service.RegistryService) http.HandlerFunc {
 			}
 		}
 
-		// Use the GetAll method to get paginated results
-		registries, nextCursor, err := registry.List(cursor, limit)
+		// Restrict the serialized server fields if requested
+		var fields []string
+		if fieldsParam := r.URL.Query().Get("fields"); fieldsParam != "" {
+			var err error
+			fields, err = parseFields(fieldsParam, model.Server{})
+			if err != nil {
+				http.Error(w, "Invalid fields parameter: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		// Only return servers updated at or after updated_since, if provided
+		var filter map[string]interface{}
+		if updatedSince := r.URL.Query().Get("updated_since"); updatedSince != "" {
+			since, err := time.Parse(time.RFC3339, updatedSince)
+			if err != nil {
+				http.Error(w, "Invalid updated_since parameter: must be an RFC 3339 timestamp", http.StatusBadRequest)
+				return
+			}
+			filter = map[string]interface{}{"updated_since": since}
+		}
+
+		// Use the List method to get paginated results
+		registries, nextCursor, err := registry.List(filter, cursor, limit)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 
-		// Create paginated response
-		response := PaginatedResponse{
-			Data: registries,
-		}
-
 		// Add metadata if there's a next cursor
+		var metadata Metadata
 		if nextCursor != "" {
-			response.Metadata = Metadata{
+			metadata = Metadata{
 				NextCursor: nextCursor,
 				Count:      len(registries),
 			}
 		}
 
+		// Create paginated response, keeping only the requested fields if any
+		var response any = PaginatedResponse{
+			Data:     registries,
+			Metadata: metadata,
+		}
+		if fields != nil {
+			sparse, err := selectFields(registries, fields)
+			if err != nil {
+				http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+				return
+			}
+			response = SparsePaginatedResponse{
+				Data:     sparse,
+				Metadata: metadata,
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(response); err != nil {
 			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
@@ -62,7 +63,7 @@ func TestServersHandler(t *testing.T) {
 						},
 					},
 				}
-				registry.Mock.On("List", "", 30).Return(servers, "", nil)
+				registry.Mock.On("List", map[string]interface{}(nil), "", 30).Return(servers, "", nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedServers: []model.Server{
@@ -121,7 +122,7 @@ func TestServersHandler(t *testing.T) {
 					},
 				}
 				nextCursor := uuid.New().String()
-				registry.Mock.On("List", mock.AnythingOfType("string"), 10).Return(servers, nextCursor, nil)
+				registry.Mock.On("List", map[string]interface{}(nil), mock.AnythingOfType("string"), 10).Return(servers, nextCursor, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedServers: []model.Server{
@@ -152,11 +153,31 @@ func TestServersHandler(t *testing.T) {
 			queryParams: "?limit=150",
 			setupMocks: func(registry *MockRegistryService) {
 				servers := []model.Server{}
-				registry.Mock.On("List", "", 100).Return(servers, "", nil)
+				registry.Mock.On("List", map[string]interface{}(nil), "", 100).Return(servers, "", nil)
 			},
 			expectedStatus:  http.StatusOK,
 			expectedServers: []model.Server{},
 		},
+		{
+			name:        "successful list with updated_since filter",
+			method:      http.MethodGet,
+			queryParams: "?updated_since=2025-05-26T00:00:00Z",
+			setupMocks: func(registry *MockRegistryService) {
+				since, _ := time.Parse(time.RFC3339, "2025-05-26T00:00:00Z")
+				servers := []model.Server{}
+				registry.Mock.On("List", map[string]interface{}{"updated_since": since}, "", 30).Return(servers, "", nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedServers: []model.Server{},
+		},
+		{
+			name:           "invalid updated_since parameter",
+			method:         http.MethodGet,
+			queryParams:    "?updated_since=yesterday",
+			setupMocks:     func(_ *MockRegistryService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid updated_since parameter",
+		},
 		{
 			name:           "invalid cursor parameter",
 			method:         http.MethodGet,
@@ -193,7 +214,7 @@ func TestServersHandler(t *testing.T) {
 			name:   "registry service error",
 			method: http.MethodGet,
 			setupMocks: func(registry *MockRegistryService) {
-				registry.Mock.On("List", "", 30).Return([]model.Server{}, "", errors.New("database connection error"))
+				registry.Mock.On("List", map[string]interface{}(nil), "", 30).Return([]model.Server{}, "", errors.New("database connection error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "database connection error",
@@ -262,6 +283,96 @@ func TestServersHandler(t *testing.T) {
 	}
 }
 
+func TestServersHandlerSparseFields(t *testing.T) {
+	servers := []model.Server{
+		{
+			ID:          "550e8400-e29b-41d4-a716-446655440001",
+			Name:        "test-server-1",
+			Description: "First test server",
+			Repository: model.Repository{
+				URL:    "https://github.com/example/test-server-1",
+				Source: "github",
+				ID:     "example/test-server-1",
+			},
+			VersionDetail: model.VersionDetail{
+				Version:     "1.0.0",
+				ReleaseDate: "2025-05-25T00:00:00Z",
+				IsLatest:    true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		queryParams    string
+		expectedStatus int
+		expectedFields []string
+		expectedError  string
+	}{
+		{
+			name:           "only requested fields are returned",
+			queryParams:    "?fields=id,name",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"id", "name"},
+		},
+		{
+			name:           "nested objects are returned whole",
+			queryParams:    "?fields=version_detail,%20id",
+			expectedStatus: http.StatusOK,
+			expectedFields: []string{"id", "version_detail"},
+		},
+		{
+			name:           "unknown field is rejected",
+			queryParams:    "?fields=id,installation_count",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid fields parameter: unknown field \"installation_count\"",
+		},
+		{
+			name:           "empty field list is rejected",
+			queryParams:    "?fields=,",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid fields parameter: no fields requested",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRegistry := new(MockRegistryService)
+			mockRegistry.Mock.On("List", map[string]interface{}(nil), "", 30).Return(servers, "", nil)
+
+			handler := v0.ServersHandler(mockRegistry)
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/v0/servers"+tc.queryParams, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				assert.Contains(t, rr.Body.String(), tc.expectedError)
+				mockRegistry.Mock.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			var resp v0.SparsePaginatedResponse
+			err = json.NewDecoder(rr.Body).Decode(&resp)
+			assert.NoError(t, err)
+
+			assert.Len(t, resp.Data, 1)
+			keys := make([]string, 0, len(resp.Data[0]))
+			for key := range resp.Data[0] {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, tc.expectedFields, keys)
+			assert.JSONEq(t, `"550e8400-e29b-41d4-a716-446655440001"`, string(resp.Data[0]["id"]))
+		})
+	}
+}
+
 // TestServersHandlerIntegration tests the servers list handler with actual HTTP requests
 func TestServersHandlerIntegration(t *testing.T) {
 	// Create mock registry service
@@ -285,7 +396,7 @@ func TestServersHandlerIntegration(t *testing.T) {
 		},
 	}
 
-	mockRegistry.Mock.On("List", "", 30).Return(servers, "", nil)
+	mockRegistry.Mock.On("List", map[string]interface{}(nil), "", 30).Return(servers, "", nil)
 
 	// Create test server
 	server := httptest.NewServer(v0.ServersHandler(mockRegistry))
@@ -0,0 +1,70 @@
+package v0_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v0 "github.com/modelcontextprotocol/registry/internal/api/handlers/v0"
+	"github.com/modelcontextprotocol/registry/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionHandler(t *testing.T) {
+	cfg := &config.Config{
+		BuildVersion: "1.2.3",
+		GitCommit:    "abc1234",
+		BuildTime:    "2025-06-01T00:00:00Z",
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		expectedStatus int
+	}{
+		{
+			name:           "returns build information",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := v0.VersionHandler(cfg)
+
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, "/v0/version", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+			// Check the raw field names so the JSON contract is covered too
+			var resp map[string]string
+			err = json.NewDecoder(rr.Body).Decode(&resp)
+			assert.NoError(t, err)
+
+			assert.Equal(t, map[string]string{
+				"version":    "1.2.3",
+				"git_commit": "abc1234",
+				"build_time": "2025-06-01T00:00:00Z",
+			}, resp)
+		})
+	}
+}
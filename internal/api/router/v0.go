@@ -19,7 +19,8 @@ func RegisterV0Routes(
 	mux.HandleFunc("/v0/servers", v0.ServersHandler(registry))
 	mux.HandleFunc("/v0/servers/{id}", v0.ServersDetailHandler(registry))
 	mux.HandleFunc("/v0/ping", v0.PingHandler(cfg))
-	mux.HandleFunc("/v0/publish", v0.PublishHandler(registry, authService))
+	mux.HandleFunc("/v0/version", v0.VersionHandler(cfg))
+	mux.HandleFunc("/v0/publish", v0.PublishHandler(cfg, registry, authService))
 
 	// Register Swagger UI routes
 	mux.HandleFunc("/v0/swagger/", v0.SwaggerHandler())
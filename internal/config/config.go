@@ -13,17 +13,25 @@ const (
 
 // Config holds the application configuration
 type Config struct {
-	ServerAddress      string       `env:"SERVER_ADDRESS" envDefault:":8080"`
-	DatabaseType       DatabaseType `env:"DATABASE_TYPE" envDefault:"mongodb"`
-	DatabaseURL        string       `env:"DATABASE_URL" envDefault:"mongodb://localhost:27017"`
-	DatabaseName       string       `env:"DATABASE_NAME" envDefault:"mcp-registry"`
-	CollectionName     string       `env:"COLLECTION_NAME" envDefault:"servers_v2"`
-	LogLevel           string       `env:"LOG_LEVEL" envDefault:"info"`
-	SeedFilePath       string       `env:"SEED_FILE_PATH" envDefault:"data/seed.json"`
-	SeedImport         bool         `env:"SEED_IMPORT" envDefault:"true"`
-	Version            string       `env:"VERSION" envDefault:"dev"`
-	GithubClientID     string       `env:"GITHUB_CLIENT_ID" envDefault:""`
-	GithubClientSecret string       `env:"GITHUB_CLIENT_SECRET" envDefault:""`
+	ServerAddress         string       `env:"SERVER_ADDRESS" envDefault:":8080"`
+	DatabaseType          DatabaseType `env:"DATABASE_TYPE" envDefault:"mongodb"`
+	DatabaseURL           string       `env:"DATABASE_URL" envDefault:"mongodb://localhost:27017"`
+	DatabaseName          string       `env:"DATABASE_NAME" envDefault:"mcp-registry"`
+	CollectionName        string       `env:"COLLECTION_NAME" envDefault:"servers_v2"`
+	LogLevel              string       `env:"LOG_LEVEL" envDefault:"info"`
+	SeedFilePath          string       `env:"SEED_FILE_PATH" envDefault:"data/seed.json"`
+	SeedImport            bool         `env:"SEED_IMPORT" envDefault:"true"`
+	Version               string       `env:"VERSION" envDefault:"dev"`
+	GithubClientID        string       `env:"GITHUB_CLIENT_ID" envDefault:""`
+	GithubClientSecret    string       `env:"GITHUB_CLIENT_SECRET" envDefault:""`
+	MaintenanceMode       bool         `env:"MAINTENANCE_MODE" envDefault:"false"`
+	MaintenanceRetryAfter int          `env:"MAINTENANCE_RETRY_AFTER" envDefault:"300"`
+	MaxRequestBodySize    int64        `env:"MAX_REQUEST_BODY_SIZE" envDefault:"1048576"`
+
+	// Build information embedded in the binary, set at startup rather than from the environment
+	BuildVersion string
+	GitCommit    string
+	BuildTime    string
 }
 
 // NewConfig creates a new configuration with default values
@@ -18,7 +18,8 @@ var (
 
 // Database defines the interface for database operations on MCPRegistry entries
 type Database interface {
-	// List retrieves all MCPRegistry entries with optional filtering
+	// List retrieves all MCPRegistry entries with optional filtering.
+	// Supported filter keys include "name", "version" and "updated_since" (a time.Time)
 	List(ctx context.Context, filter map[string]interface{}, cursor string, limit int) ([]*model.Server, string, error)
 	// GetByID retrieves a single ServerDetail by it's ID
 	GetByID(ctx context.Context, id string) (*model.ServerDetail, error)
@@ -136,6 +136,11 @@ func (db *MemoryDB) List(
 				if entry.VersionDetail.Version != value.(string) {
 					include = false
 				}
+			case "updated_since":
+				releaseDate, err := time.Parse(time.RFC3339, entry.VersionDetail.ReleaseDate)
+				if err != nil || releaseDate.Before(value.(time.Time)) {
+					include = false
+				}
 				// Add more filter options as needed
 			}
 		}
@@ -243,7 +248,7 @@ func (db *MemoryDB) Publish(ctx context.Context, serverDetail *model.ServerDetai
 	// Generate a new ID for the server detail
 	serverDetail.ID = uuid.New().String()
 	serverDetail.VersionDetail.IsLatest = true // Assume the new version is the latest
-	serverDetail.VersionDetail.ReleaseDate = time.Now().Format(time.RFC3339)
+	serverDetail.VersionDetail.ReleaseDate = time.Now().UTC().Format(time.RFC3339)
 	// Store a copy of the entire ServerDetail
 	serverDetailCopy := *serverDetail
 	db.entries[serverDetail.ID] = &serverDetailCopy
@@ -100,6 +100,11 @@ func (db *MongoDB) List(
 			mongoFilter["version_detail.version"] = v
 		case "name":
 			mongoFilter["name"] = v
+		case "updated_since":
+			// Release dates are stored as UTC RFC3339 strings, which sort chronologically
+			if since, ok := v.(time.Time); ok {
+				mongoFilter["version_detail.release_date"] = bson.M{"$gte": since.UTC().Format(time.RFC3339)}
+			}
 		default:
 			mongoFilter[k] = v
 		}
@@ -207,7 +212,7 @@ func (db *MongoDB) Publish(ctx context.Context, serverDetail *model.ServerDetail
 
 	serverDetail.ID = uuid.New().String()
 	serverDetail.VersionDetail.IsLatest = true
-	serverDetail.VersionDetail.ReleaseDate = time.Now().Format(time.RFC3339)
+	serverDetail.VersionDetail.ReleaseDate = time.Now().UTC().Format(time.RFC3339)
 
 	// Insert the entry into the database
 	_, err = db.collection.InsertOne(ctx, serverDetail)
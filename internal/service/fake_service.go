@@ -79,13 +79,13 @@ func NewFakeRegistryService() RegistryService {
 }
 
 // List retrieves MCPRegistry entries with optional filtering and pagination
-func (s *fakeRegistryService) List(cursor string, limit int) ([]model.Server, string, error) {
+func (s *fakeRegistryService) List(filter map[string]interface{}, cursor string, limit int) ([]model.Server, string, error) {
 	// Create a timeout context for the database operation
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Use the database's List method with no filters to get all entries
-	entries, nextCursor, err := s.db.List(ctx, nil, cursor, limit)
+	// Use the database's List method with the provided filters
+	entries, nextCursor, err := s.db.List(ctx, filter, cursor, limit)
 	if err != nil {
 		return nil, "", err
 	}
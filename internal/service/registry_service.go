@@ -43,8 +43,8 @@ func (s *registryServiceImpl) GetAll() ([]model.Server, error) {
 	return result, nil
 }
 
-// List returns registry entries with cursor-based pagination
-func (s *registryServiceImpl) List(cursor string, limit int) ([]model.Server, string, error) {
+// List returns registry entries with optional filtering and cursor-based pagination
+func (s *registryServiceImpl) List(filter map[string]interface{}, cursor string, limit int) ([]model.Server, string, error) {
 	// Create a timeout context for the database operation
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -54,8 +54,8 @@ func (s *registryServiceImpl) List(cursor string, limit int) ([]model.Server, st
 		limit = 30
 	}
 
-	// Use the database's List method with pagination
-	entries, nextCursor, err := s.db.List(ctx, nil, cursor, limit)
+	// Use the database's List method with filtering and pagination
+	entries, nextCursor, err := s.db.List(ctx, filter, cursor, limit)
 	if err != nil {
 		return nil, "", err
 	}